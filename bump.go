@@ -0,0 +1,50 @@
+package semver
+
+// Clone returns a pointer to a copy of v, safe to mutate independently of v.
+func (v *Version) Clone() *Version {
+	c := *v
+	return &c
+}
+
+// Equal reports whether v and other represent the same version, including
+// build metadata. Unlike CompareTo, which ignores build metadata, Equal
+// treats "1.0.0+a" and "1.0.0+b" as different.
+func (v Version) Equal(other *Version) bool {
+	if other == nil {
+		return false
+	}
+	return v.major == other.major && v.minor == other.minor && v.patch == other.patch &&
+		v.preRelease == other.preRelease && v.build == other.build
+}
+
+// BumpMajor returns a new Version with the major version incremented and the
+// minor and patch versions reset to 0, leaving v unchanged.
+func (v *Version) BumpMajor() *Version {
+	c := v.Clone()
+	c.IncreaseMajor()
+	return c
+}
+
+// BumpMinor returns a new Version with the minor version incremented and the
+// patch version reset to 0, leaving v unchanged.
+func (v *Version) BumpMinor() *Version {
+	c := v.Clone()
+	c.IncreaseMinor()
+	return c
+}
+
+// BumpPatch returns a new Version with the patch version incremented,
+// leaving v unchanged.
+func (v *Version) BumpPatch() *Version {
+	c := v.Clone()
+	c.IncreasePatch()
+	return c
+}
+
+// BumpPreRelease returns a new Version with the pre-release version
+// incremented, leaving v unchanged.
+func (v *Version) BumpPreRelease() *Version {
+	c := v.Clone()
+	c.IncreasePreRelease()
+	return c
+}
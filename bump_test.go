@@ -0,0 +1,85 @@
+package semver
+
+import "testing"
+
+func TestVersion_Clone(t *testing.T) {
+	v, err := Parse("1.2.3-alpha+build")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	c := v.Clone()
+	c.IncreaseMajor()
+
+	if v.String() != "1.2.3-alpha+build" {
+		t.Errorf("Clone() did not protect original from mutation, got %v", v)
+	}
+	if c.String() != "2.0.0-alpha+build" {
+		t.Errorf("Clone().IncreaseMajor() = %v, want 2.0.0-alpha+build", c)
+	}
+}
+
+func TestVersion_Equal(t *testing.T) {
+	a, _ := Parse("1.2.3+build.1")
+	b, _ := Parse("1.2.3+build.2")
+	c, _ := Parse("1.2.3+build.1")
+
+	if a.Equal(b) {
+		t.Errorf("Equal() = true for versions differing only in build metadata, want false")
+	}
+	if !a.Equal(c) {
+		t.Errorf("Equal() = false for identical versions, want true")
+	}
+	if a.CompareTo(b) != 0 {
+		t.Errorf("CompareTo() = %v, want 0 (build metadata should be ignored)", a.CompareTo(b))
+	}
+	if a.Equal(nil) {
+		t.Errorf("Equal(nil) = true, want false")
+	}
+}
+
+func TestVersion_BumpMethods(t *testing.T) {
+	v, err := Parse("1.2.3")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	if got := v.BumpMajor(); got.String() != "2.0.0" {
+		t.Errorf("BumpMajor() = %v, want 2.0.0", got)
+	}
+	if got := v.BumpMinor(); got.String() != "1.3.0" {
+		t.Errorf("BumpMinor() = %v, want 1.3.0", got)
+	}
+	if got := v.BumpPatch(); got.String() != "1.2.4" {
+		t.Errorf("BumpPatch() = %v, want 1.2.4", got)
+	}
+	if v.String() != "1.2.3" {
+		t.Errorf("Bump methods mutated the receiver, got %v", v)
+	}
+
+	pre, _ := Parse("1.2.3-alpha")
+	if got := pre.BumpPreRelease(); got.String() != "1.2.3-alpha.1" {
+		t.Errorf("BumpPreRelease() = %v, want 1.2.3-alpha.1", got)
+	}
+	if pre.String() != "1.2.3-alpha" {
+		t.Errorf("BumpPreRelease() mutated the receiver, got %v", pre)
+	}
+}
+
+func TestVersion_AsMapKey(t *testing.T) {
+	releases := map[Version]string{}
+	v, _ := Parse("1.2.3")
+	releases[*v] = "stable"
+
+	channel, ok := releases[*v]
+	if !ok || channel != "stable" {
+		t.Errorf("releases[%v] = (%q, %v), want (\"stable\", true)", v, channel, ok)
+	}
+
+	// A value-receiver method must be callable directly on a map read,
+	// since the Version held in the map is not addressable.
+	values := map[string]Version{"current": *v}
+	if s := values["current"].String(); s != "1.2.3" {
+		t.Errorf("values[\"current\"].String() = %v, want 1.2.3", s)
+	}
+}
@@ -0,0 +1,115 @@
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrorUnknownChannel         = errors.New("unknown pre-release channel")
+	ErrorChannelRegression      = errors.New("cannot move to an earlier pre-release channel")
+	ErrorInvalidPreReleaseLevel = errors.New(`level must be "major", "minor", or "patch"`)
+	ErrorNotPreRelease          = errors.New("version is not a pre-release; use StartPreRelease instead")
+)
+
+// PreReleaseChannels is the ordered list of recognized pre-release channel
+// names, from earliest to latest. NextPreRelease and StartPreRelease
+// validate against it, so the ordering used to detect illegal transitions
+// isn't hardcoded into their logic.
+var PreReleaseChannels = []string{"alpha", "beta", "rc"}
+
+func channelIndex(name string) int {
+	for i, c := range PreReleaseChannels {
+		if c == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// NextPreRelease advances v to the next pre-release identifier on channel.
+// Given "1.2.3-alpha.4", calling NextPreRelease("alpha") returns
+// "1.2.3-alpha.5"; calling it with a later channel resets the counter, e.g.
+// NextPreRelease("beta") returns "1.2.3-beta.1". Calling it with an earlier
+// channel than the one v is already on returns ErrorChannelRegression, an
+// unrecognized channel returns ErrorUnknownChannel, and calling it on a
+// stable version returns ErrorNotPreRelease: a stable version has no
+// pre-release band to advance within, and naively appending one would rank
+// below v under CompareTo, a regression in precedence. Use StartPreRelease
+// to open a new pre-release band from a stable version instead.
+func (v *Version) NextPreRelease(channel string) (*Version, error) {
+	targetIdx := channelIndex(channel)
+	if targetIdx < 0 {
+		return nil, ErrorUnknownChannel
+	}
+
+	if v.preRelease == "" {
+		return nil, ErrorNotPreRelease
+	}
+
+	curChannel, curCounter := splitChannelCounter(v.preRelease)
+	curIdx := channelIndex(curChannel)
+	if curIdx < 0 {
+		return nil, ErrorUnknownChannel
+	}
+
+	if targetIdx < curIdx {
+		return nil, ErrorChannelRegression
+	}
+
+	c := v.Clone()
+	if targetIdx == curIdx {
+		c.preRelease = fmt.Sprintf("%s.%d", channel, curCounter+1)
+	} else {
+		c.preRelease = fmt.Sprintf("%s.1", channel)
+	}
+	return c, nil
+}
+
+// Promote strips v's pre-release tag, turning e.g. "1.2.3-rc.7" into
+// "1.2.3".
+func (v *Version) Promote() *Version {
+	c := v.Clone()
+	c.preRelease = ""
+	return c
+}
+
+// StartPreRelease bumps the requested component (level is one of "major",
+// "minor", or "patch") and appends "-channel.1", e.g. StartPreRelease("minor",
+// "alpha") on "1.2.3" returns "1.3.0-alpha.1".
+func (v *Version) StartPreRelease(level, channel string) (*Version, error) {
+	if channelIndex(channel) < 0 {
+		return nil, ErrorUnknownChannel
+	}
+
+	c := v.Clone()
+	switch level {
+	case "major":
+		c.IncreaseMajor()
+	case "minor":
+		c.IncreaseMinor()
+	case "patch":
+		c.IncreasePatch()
+	default:
+		return nil, ErrorInvalidPreReleaseLevel
+	}
+
+	c.preRelease = fmt.Sprintf("%s.1", channel)
+	return c, nil
+}
+
+// splitChannelCounter splits a pre-release tag such as "alpha.4" into its
+// channel name and numeric counter. A missing or non-numeric counter is
+// reported as 0.
+func splitChannelCounter(preRelease string) (channel string, counter int) {
+	parts := strings.SplitN(preRelease, ".", 2)
+	channel = parts[0]
+	if len(parts) == 2 {
+		if n, err := strconv.Atoi(parts[1]); err == nil {
+			counter = n
+		}
+	}
+	return channel, counter
+}
@@ -0,0 +1,109 @@
+package semver
+
+import "testing"
+
+func TestVersion_NextPreRelease(t *testing.T) {
+	v, err := Parse("1.2.3-alpha.4")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	next, err := v.NextPreRelease("alpha")
+	if err != nil {
+		t.Fatalf("NextPreRelease(\"alpha\") returned error: %v", err)
+	}
+	if next.String() != "1.2.3-alpha.5" {
+		t.Errorf("NextPreRelease(\"alpha\") = %v, want 1.2.3-alpha.5", next)
+	}
+
+	next, err = v.NextPreRelease("beta")
+	if err != nil {
+		t.Fatalf("NextPreRelease(\"beta\") returned error: %v", err)
+	}
+	if next.String() != "1.2.3-beta.1" {
+		t.Errorf("NextPreRelease(\"beta\") = %v, want 1.2.3-beta.1", next)
+	}
+
+	if v.String() != "1.2.3-alpha.4" {
+		t.Errorf("NextPreRelease mutated the receiver, got %v", v)
+	}
+}
+
+func TestVersion_NextPreRelease_RejectsRegression(t *testing.T) {
+	v, err := Parse("1.2.3-rc.1")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	if _, err := v.NextPreRelease("alpha"); err != ErrorChannelRegression {
+		t.Errorf("NextPreRelease(\"alpha\") from rc = %v, want ErrorChannelRegression", err)
+	}
+}
+
+func TestVersion_NextPreRelease_UnknownChannel(t *testing.T) {
+	v, err := Parse("1.2.3-alpha.1")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	if _, err := v.NextPreRelease("nightly"); err != ErrorUnknownChannel {
+		t.Errorf("NextPreRelease(\"nightly\") = %v, want ErrorUnknownChannel", err)
+	}
+}
+
+func TestVersion_NextPreRelease_FromStable(t *testing.T) {
+	v, err := Parse("1.2.3")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	if _, err := v.NextPreRelease("alpha"); err != ErrorNotPreRelease {
+		t.Errorf("NextPreRelease(\"alpha\") from stable = %v, want ErrorNotPreRelease", err)
+	}
+}
+
+func TestVersion_Promote(t *testing.T) {
+	v, err := Parse("1.2.3-rc.7")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	promoted := v.Promote()
+	if promoted.String() != "1.2.3" {
+		t.Errorf("Promote() = %v, want 1.2.3", promoted)
+	}
+	if v.String() != "1.2.3-rc.7" {
+		t.Errorf("Promote() mutated the receiver, got %v", v)
+	}
+}
+
+func TestVersion_StartPreRelease(t *testing.T) {
+	tests := []struct {
+		version  string
+		level    string
+		channel  string
+		expected string
+		hasError bool
+	}{
+		{"1.2.3", "major", "alpha", "2.0.0-alpha.1", false},
+		{"1.2.3", "minor", "alpha", "1.3.0-alpha.1", false},
+		{"1.2.3", "patch", "alpha", "1.2.4-alpha.1", false},
+		{"1.2.3", "bogus", "alpha", "", true},
+		{"1.2.3", "minor", "nightly", "", true},
+	}
+
+	for _, test := range tests {
+		v, err := Parse(test.version)
+		if err != nil {
+			t.Fatalf("Parse returned error: %v", err)
+		}
+		got, err := v.StartPreRelease(test.level, test.channel)
+		if (err != nil) != test.hasError {
+			t.Errorf("StartPreRelease(%q, %q) error = %v, wantErr %v", test.level, test.channel, err, test.hasError)
+			continue
+		}
+		if err == nil && got.String() != test.expected {
+			t.Errorf("StartPreRelease(%q, %q) = %v, want %v", test.level, test.channel, got, test.expected)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package semver
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// jsonNull is the JSON literal for a null value.
+const jsonNull = "null"
+
+// MarshalText implements encoding.TextMarshaler, emitting the canonical
+// String() form. It has a value receiver so both Version and *Version
+// marshal the same way.
+func (v Version) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It parses text the same
+// way Parse does, surfacing the same ErrorInvalidVersion-family errors.
+func (v *Version) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*v = *parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, emitting the canonical String()
+// form as a JSON string. It has a value receiver so both Version and
+// *Version marshal the same way.
+func (v Version) MarshalJSON() ([]byte, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	quoted := make([]byte, 0, len(text)+2)
+	quoted = append(quoted, '"')
+	quoted = append(quoted, text...)
+	quoted = append(quoted, '"')
+	return quoted, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The JSON value must be a string
+// in the form Parse accepts, or the literal null, which leaves the Version
+// unchanged (mirroring Scan(nil)).
+func (v *Version) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return ErrorInvalidVersion
+	}
+	return v.UnmarshalText(data[1 : len(data)-1])
+}
+
+// Scan implements database/sql.Scanner, allowing a Version to be read
+// directly from a database column. It accepts string, []byte, and nil
+// (which leaves the Version unchanged).
+func (v *Version) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	switch src := value.(type) {
+	case string:
+		return v.UnmarshalText([]byte(src))
+	case []byte:
+		return v.UnmarshalText(src)
+	default:
+		return fmt.Errorf("semver: cannot scan %T into Version", value)
+	}
+}
+
+// Value implements database/sql/driver.Valuer, storing the canonical
+// String() form.
+func (v *Version) Value() (driver.Value, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return v.String(), nil
+}
@@ -0,0 +1,156 @@
+package semver
+
+import (
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestVersion_TextMarshaling(t *testing.T) {
+	v, err := Parse("1.2.3-beta.1+build.5")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	var _ encoding.TextMarshaler = *v
+	var _ encoding.TextUnmarshaler = v
+
+	text, err := v.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText returned error: %v", err)
+	}
+	if string(text) != "1.2.3-beta.1+build.5" {
+		t.Errorf("MarshalText() = %q, want %q", text, "1.2.3-beta.1+build.5")
+	}
+
+	var got Version
+	if err := got.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText returned error: %v", err)
+	}
+	if !reflect.DeepEqual(got, *v) {
+		t.Errorf("UnmarshalText() = %v, want %v", got, *v)
+	}
+
+	if err := got.UnmarshalText([]byte("not-a-version")); err == nil {
+		t.Errorf("UnmarshalText(%q) expected error, got nil", "not-a-version")
+	}
+}
+
+func TestVersion_JSONMarshaling(t *testing.T) {
+	v, err := Parse("2.0.1")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	// Value receiver.
+	data, err := json.Marshal(*v)
+	if err != nil {
+		t.Fatalf("json.Marshal(Version) returned error: %v", err)
+	}
+	if string(data) != `"2.0.1"` {
+		t.Errorf("json.Marshal(Version) = %s, want %q", data, `"2.0.1"`)
+	}
+
+	// Pointer receiver.
+	data, err = json.Marshal(v)
+	if err != nil {
+		t.Fatalf("json.Marshal(*Version) returned error: %v", err)
+	}
+	if string(data) != `"2.0.1"` {
+		t.Errorf("json.Marshal(*Version) = %s, want %q", data, `"2.0.1"`)
+	}
+
+	var got Version
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	if !reflect.DeepEqual(got, *v) {
+		t.Errorf("json.Unmarshal() = %v, want %v", got, *v)
+	}
+
+	if err := json.Unmarshal([]byte(`123`), &got); err == nil {
+		t.Errorf("json.Unmarshal(123) expected error, got nil")
+	}
+
+	type wrapper struct {
+		Version *Version `json:"version"`
+	}
+	w := wrapper{Version: v}
+	wdata, err := json.Marshal(w)
+	if err != nil {
+		t.Fatalf("json.Marshal(wrapper) returned error: %v", err)
+	}
+	var w2 wrapper
+	if err := json.Unmarshal(wdata, &w2); err != nil {
+		t.Fatalf("json.Unmarshal(wrapper) returned error: %v", err)
+	}
+	if w2.Version.String() != v.String() {
+		t.Errorf("wrapper round-trip = %v, want %v", w2.Version, v)
+	}
+}
+
+func TestVersion_JSONMarshaling_Null(t *testing.T) {
+	v, err := Parse("3.1.4")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	if err := json.Unmarshal([]byte("null"), v); err != nil {
+		t.Fatalf("json.Unmarshal(null) returned error: %v", err)
+	}
+	if v.String() != "3.1.4" {
+		t.Errorf("json.Unmarshal(null) should leave Version unchanged, got %v", v)
+	}
+}
+
+func TestVersion_SQLScanAndValue(t *testing.T) {
+	v, err := Parse("1.4.0")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	var _ driver.Valuer = v
+
+	val, err := v.Value()
+	if err != nil {
+		t.Fatalf("Value() returned error: %v", err)
+	}
+	if val != "1.4.0" {
+		t.Errorf("Value() = %v, want %q", val, "1.4.0")
+	}
+
+	var nilVersion *Version
+	val, err = nilVersion.Value()
+	if err != nil || val != nil {
+		t.Errorf("nil Version.Value() = (%v, %v), want (nil, nil)", val, err)
+	}
+
+	var got Version
+	if err := got.Scan("1.4.0"); err != nil {
+		t.Fatalf("Scan(string) returned error: %v", err)
+	}
+	if got.String() != "1.4.0" {
+		t.Errorf("Scan(string) = %v, want 1.4.0", got)
+	}
+
+	if err := got.Scan([]byte("2.5.0")); err != nil {
+		t.Fatalf("Scan([]byte) returned error: %v", err)
+	}
+	if got.String() != "2.5.0" {
+		t.Errorf("Scan([]byte) = %v, want 2.5.0", got)
+	}
+
+	// Nullable SQL column.
+	if err := got.Scan(nil); err != nil {
+		t.Fatalf("Scan(nil) returned error: %v", err)
+	}
+	if got.String() != "2.5.0" {
+		t.Errorf("Scan(nil) should leave Version unchanged, got %v", got)
+	}
+
+	if err := got.Scan(42); err == nil {
+		t.Errorf("Scan(42) expected error, got nil")
+	}
+}
@@ -0,0 +1,109 @@
+package semver
+
+import "strings"
+
+// ParseOptions controls the leniency of ParseWithOptions.
+type ParseOptions struct {
+	// AllowV accepts a leading "v" or "V", as golang.org/x/mod/semver requires.
+	AllowV bool
+	// AllowShort accepts shortened forms such as "1.2" or "1", filling
+	// missing components with 0, as Masterminds/semver does.
+	AllowShort bool
+	// RequireStable rejects any version carrying a pre-release tag or build
+	// metadata, accepting only a plain "X.Y.Z".
+	RequireStable bool
+	// MaxComponents bounds how many dot-separated numeric components the
+	// input may supply. Zero means the default of 3 (major.minor.patch).
+	// It is consulted only when AllowShort is set.
+	MaxComponents int
+}
+
+// ParseWithOptions parses s according to opts, relaxing or tightening the
+// rules Parse enforces. The returned Version remembers whether s carried a
+// "v" prefix so String's Original/Canonical pair can re-emit it.
+func ParseWithOptions(s string, opts ParseOptions) (*Version, error) {
+	vPrefix := false
+	if opts.AllowV && len(s) > 0 && (s[0] == 'v' || s[0] == 'V') {
+		vPrefix = true
+		s = s[1:]
+	}
+
+	if opts.AllowShort {
+		expanded, err := expandShortVersion(s, opts.MaxComponents)
+		if err != nil {
+			return nil, err
+		}
+		s = expanded
+	}
+
+	v, err := Parse(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.RequireStable && (v.preRelease != "" || v.build != "") {
+		return nil, ErrorInvalidVersion
+	}
+
+	v.vPrefix = vPrefix
+	return v, nil
+}
+
+// ParseTolerant parses s the way golang.org/x/mod/semver and Masterminds
+// semver do: a leading "v" is accepted and missing minor/patch components
+// default to 0.
+func ParseTolerant(s string) (*Version, error) {
+	return ParseWithOptions(s, ParseOptions{AllowV: true, AllowShort: true})
+}
+
+// ParseStrict parses s as a plain "X.Y.Z", rejecting pre-release tags and
+// build metadata in addition to the leniencies Parse already disallows.
+func ParseStrict(s string) (*Version, error) {
+	return ParseWithOptions(s, ParseOptions{RequireStable: true})
+}
+
+// expandShortVersion fills missing minor/patch components of s with 0,
+// rejecting inputs with more dot-separated components than maxComponents
+// (0 means the default of 3).
+func expandShortVersion(s string, maxComponents int) (string, error) {
+	if maxComponents <= 0 {
+		maxComponents = 3
+	}
+
+	core, suffix := s, ""
+	if idx := strings.IndexAny(s, "-+"); idx >= 0 {
+		core, suffix = s[:idx], s[idx:]
+	}
+
+	parts := strings.Split(core, ".")
+	if len(parts) > maxComponents {
+		return "", ErrorInvalidVersion
+	}
+	for _, p := range parts {
+		if p == "" {
+			return "", ErrorInvalidVersion
+		}
+	}
+	for len(parts) < 3 {
+		parts = append(parts, "0")
+	}
+
+	return strings.Join(parts, ".") + suffix, nil
+}
+
+// Canonical returns the version's canonical "X.Y.Z[-PRERELEASE][+BUILD]"
+// form, the same as String.
+func (v *Version) Canonical() string {
+	return v.String()
+}
+
+// Original returns the canonical form with a leading "v" re-added if
+// ParseWithOptions consumed a "v" or "V" prefix. The prefix is always
+// re-emitted as lowercase "v", and a shortened input such as "v1.2" comes
+// back in its expanded form ("v1.2.0").
+func (v *Version) Original() string {
+	if v.vPrefix {
+		return "v" + v.String()
+	}
+	return v.String()
+}
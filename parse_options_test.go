@@ -0,0 +1,86 @@
+package semver
+
+import "testing"
+
+func TestParseWithOptions(t *testing.T) {
+	tests := []struct {
+		input    string
+		opts     ParseOptions
+		expected string
+		hasError bool
+	}{
+		{"v1.2.3", ParseOptions{AllowV: true}, "1.2.3", false},
+		{"1.2.3", ParseOptions{AllowV: true}, "1.2.3", false},
+		{"v1.2.3", ParseOptions{}, "", true},
+
+		{"1.2", ParseOptions{AllowShort: true}, "1.2.0", false},
+		{"1", ParseOptions{AllowShort: true}, "1.0.0", false},
+		{"1.2", ParseOptions{}, "", true},
+
+		{"v1.2", ParseOptions{AllowV: true, AllowShort: true}, "1.2.0", false},
+
+		{"1.2.3-alpha", ParseOptions{RequireStable: true}, "", true},
+		{"1.2.3+build", ParseOptions{RequireStable: true}, "", true},
+		{"1.2.3", ParseOptions{RequireStable: true}, "1.2.3", false},
+
+		{"1.2.3.4", ParseOptions{AllowShort: true, MaxComponents: 3}, "", true},
+	}
+
+	for _, test := range tests {
+		v, err := ParseWithOptions(test.input, test.opts)
+		if (err != nil) != test.hasError {
+			t.Errorf("ParseWithOptions(%q, %+v) error = %v, wantErr %v", test.input, test.opts, err, test.hasError)
+			continue
+		}
+		if err == nil && v.String() != test.expected {
+			t.Errorf("ParseWithOptions(%q, %+v) = %v, want %v", test.input, test.opts, v.String(), test.expected)
+		}
+	}
+}
+
+func TestParseTolerant(t *testing.T) {
+	v, err := ParseTolerant("v1.2")
+	if err != nil {
+		t.Fatalf("ParseTolerant returned error: %v", err)
+	}
+	if v.String() != "1.2.0" {
+		t.Errorf("ParseTolerant(\"v1.2\") = %v, want 1.2.0", v.String())
+	}
+	if v.Original() != "v1.2.0" {
+		t.Errorf("ParseTolerant(\"v1.2\").Original() = %v, want v1.2.0", v.Original())
+	}
+}
+
+func TestParseStrict(t *testing.T) {
+	if _, err := ParseStrict("1.2.3-alpha"); err == nil {
+		t.Errorf("ParseStrict(\"1.2.3-alpha\") expected error, got nil")
+	}
+	v, err := ParseStrict("1.2.3")
+	if err != nil {
+		t.Fatalf("ParseStrict returned error: %v", err)
+	}
+	if v.String() != "1.2.3" {
+		t.Errorf("ParseStrict(\"1.2.3\") = %v, want 1.2.3", v.String())
+	}
+}
+
+func TestVersion_CanonicalAndOriginal(t *testing.T) {
+	v, err := ParseWithOptions("V1.2.3", ParseOptions{AllowV: true})
+	if err != nil {
+		t.Fatalf("ParseWithOptions returned error: %v", err)
+	}
+	if v.Canonical() != "1.2.3" {
+		t.Errorf("Canonical() = %v, want 1.2.3", v.Canonical())
+	}
+	if v.Original() != "v1.2.3" {
+		t.Errorf("Original() = %v, want v1.2.3", v.Original())
+	}
+
+	plain, err := Parse("1.2.3")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if plain.Original() != plain.Canonical() {
+		t.Errorf("Original() = %v, want equal to Canonical() %v for a non-prefixed version", plain.Original(), plain.Canonical())
+	}
+}
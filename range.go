@@ -0,0 +1,434 @@
+package semver
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// ErrorInvalidRange is returned when a range string cannot be parsed.
+var ErrorInvalidRange = errors.New("invalid range format")
+
+// Op identifies the comparison performed by a comparator node in a Range AST.
+type Op int
+
+const (
+	OpGTE Op = iota
+	OpGT
+	OpLTE
+	OpLT
+	OpEQ
+)
+
+// String returns the textual operator, e.g. ">=".
+func (o Op) String() string {
+	switch o {
+	case OpGTE:
+		return ">="
+	case OpGT:
+		return ">"
+	case OpLTE:
+		return "<="
+	case OpLT:
+		return "<"
+	case OpEQ:
+		return "="
+	default:
+		return "?"
+	}
+}
+
+// Range is a version acceptance predicate built from comparator, AND, and OR
+// nodes. Ranges are immutable and safe to share between goroutines.
+type Range interface {
+	// Contains reports whether v satisfies the range.
+	Contains(v *Version) bool
+	// String returns a textual form of the range. For a range returned by
+	// ParseRange, ParseRange reads that form back to an equivalent range.
+	// Nesting is not written out: an Or placed inside an And prints as
+	// "a || b c", which ParseRange reads as Or(a, And(b, c)).
+	String() string
+}
+
+// And returns a Range that is satisfied only when every one of nodes is
+// satisfied. A version with a pre-release tag only satisfies an And node if
+// one of its direct comparator children names the same major.minor.patch
+// with a pre-release tag of its own (the npm/blang rule).
+func And(nodes ...Range) Range {
+	return &andNode{nodes: nodes}
+}
+
+// Or returns a Range that is satisfied when any one of nodes is satisfied.
+func Or(nodes ...Range) Range {
+	return &orNode{nodes: nodes}
+}
+
+// Comparator returns a single leaf Range comparing against v using op.
+func Comparator(op Op, v *Version) Range {
+	return &comparatorNode{op: op, version: v}
+}
+
+type andNode struct {
+	nodes []Range
+}
+
+func (a *andNode) Contains(v *Version) bool {
+	if v.IsPreRelease() {
+		allowed := false
+		for _, n := range a.nodes {
+			if c, ok := n.(*comparatorNode); ok && c.version.IsPreRelease() &&
+				c.version.major == v.major && c.version.minor == v.minor && c.version.patch == v.patch {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false
+		}
+	}
+	for _, n := range a.nodes {
+		if !n.Contains(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (a *andNode) String() string {
+	parts := make([]string, len(a.nodes))
+	for i, n := range a.nodes {
+		parts[i] = n.String()
+	}
+	return strings.Join(parts, " ")
+}
+
+type orNode struct {
+	nodes []Range
+}
+
+func (o *orNode) Contains(v *Version) bool {
+	for _, n := range o.nodes {
+		if n.Contains(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func (o *orNode) String() string {
+	parts := make([]string, len(o.nodes))
+	for i, n := range o.nodes {
+		parts[i] = n.String()
+	}
+	return strings.Join(parts, " || ")
+}
+
+type comparatorNode struct {
+	op      Op
+	version *Version
+}
+
+func (c *comparatorNode) Contains(v *Version) bool {
+	cmp := v.CompareTo(c.version)
+	switch c.op {
+	case OpGTE:
+		return cmp >= 0
+	case OpGT:
+		return cmp > 0
+	case OpLTE:
+		return cmp <= 0
+	case OpLT:
+		return cmp < 0
+	case OpEQ:
+		return cmp == 0
+	default:
+		return false
+	}
+}
+
+func (c *comparatorNode) String() string {
+	return c.op.String() + c.version.String()
+}
+
+// ParseRange parses a version range expression such as ">=1.2.0 <2.0.0",
+// "~1.2.3", "^1.2.3", "1.2.x", or an OR'd set of those joined by "||", and
+// returns the resulting Range.
+func ParseRange(s string) (Range, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, ErrorInvalidRange
+	}
+
+	orParts := strings.Split(s, "||")
+	nodes := make([]Range, 0, len(orParts))
+	for _, part := range orParts {
+		node, err := parseComparatorSet(strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+	return Or(nodes...), nil
+}
+
+// MustParseRange is like ParseRange but panics if s cannot be parsed.
+func MustParseRange(s string) Range {
+	r, err := ParseRange(s)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+func parseComparatorSet(s string) (Range, error) {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return nil, ErrorInvalidRange
+	}
+
+	var nodes []Range
+	for _, tok := range fields {
+		tokNodes, err := parseComparatorToken(tok)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, tokNodes...)
+	}
+	return And(nodes...), nil
+}
+
+func parseComparatorToken(tok string) ([]Range, error) {
+	switch {
+	case strings.HasPrefix(tok, ">="):
+		return parseSimpleComparator(OpGTE, tok[2:])
+	case strings.HasPrefix(tok, "<="):
+		return parseSimpleComparator(OpLTE, tok[2:])
+	case strings.HasPrefix(tok, ">"):
+		return parseSimpleComparator(OpGT, tok[1:])
+	case strings.HasPrefix(tok, "<"):
+		return parseSimpleComparator(OpLT, tok[1:])
+	case strings.HasPrefix(tok, "="):
+		return parseSimpleComparator(OpEQ, tok[1:])
+	case strings.HasPrefix(tok, "~"):
+		return parseTilde(tok[1:])
+	case strings.HasPrefix(tok, "^"):
+		return parseCaret(tok[1:])
+	default:
+		return parsePartialOrExact(tok)
+	}
+}
+
+// parseSimpleComparator parses a ">", ">=", "<", "<=", or "=" comparator
+// whose right-hand side may be a partial version such as "1.2". A partial
+// version denotes the whole major.minor.patch band it identifies (the
+// npm/node-semver X-range rule), so the comparator is reinterpreted in terms
+// of that band's bounds rather than treating the missing components as
+// zero: ">1.2" means "greater than everything in the 1.2.x band", i.e.
+// ">=1.3.0", and "<=1.2" means "less than the next band", i.e. "<1.3.0".
+func parseSimpleComparator(op Op, rest string) ([]Range, error) {
+	major, minor, patch, numComps, pre, err := parsePartialVersion(rest)
+	if err != nil {
+		return nil, err
+	}
+	if numComps == 3 || numComps == 0 {
+		v, err := New(major, minor, patch, pre, "")
+		if err != nil {
+			return nil, err
+		}
+		return []Range{Comparator(op, v)}, nil
+	}
+
+	lo, hi, err := partialVersionBounds(major, minor, patch, numComps, pre)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op {
+	case OpGTE:
+		return []Range{Comparator(OpGTE, lo)}, nil
+	case OpGT:
+		return []Range{Comparator(OpGTE, hi)}, nil
+	case OpLTE:
+		return []Range{Comparator(OpLT, hi)}, nil
+	case OpLT:
+		return []Range{Comparator(OpLT, lo)}, nil
+	case OpEQ:
+		return []Range{Comparator(OpGTE, lo), Comparator(OpLT, hi)}, nil
+	default:
+		return nil, ErrorInvalidRange
+	}
+}
+
+// partialVersionBounds returns the inclusive lower bound and exclusive upper
+// bound of the major.minor.patch band identified by a partial version with
+// numComps (1 or 2) explicit components, e.g. "1.2" denotes the band
+// [1.2.0, 1.3.0).
+func partialVersionBounds(major, minor, patch, numComps int, pre string) (lo, hi *Version, err error) {
+	lo, err = New(major, minor, patch, pre, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var hiMajor, hiMinor int
+	if numComps == 1 {
+		hiMajor, hiMinor = major+1, 0
+	} else {
+		hiMajor, hiMinor = major, minor+1
+	}
+	hi, err = New(hiMajor, hiMinor, 0, "", "")
+	if err != nil {
+		return nil, nil, err
+	}
+	return lo, hi, nil
+}
+
+func parsePartialOrExact(tok string) ([]Range, error) {
+	major, minor, patch, numComps, pre, err := parsePartialVersion(tok)
+	if err != nil {
+		return nil, err
+	}
+	if numComps == 3 {
+		v, err := New(major, minor, patch, pre, "")
+		if err != nil {
+			return nil, err
+		}
+		return []Range{Comparator(OpEQ, v)}, nil
+	}
+	return partialRangeNodes(major, minor, patch, numComps)
+}
+
+func parseTilde(rest string) ([]Range, error) {
+	major, minor, patch, numComps, pre, err := parsePartialVersion(rest)
+	if err != nil {
+		return nil, err
+	}
+	if numComps == 0 {
+		return nil, ErrorInvalidRange
+	}
+
+	lo, err := New(major, minor, patch, pre, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var hiMajor, hiMinor int
+	if numComps == 1 {
+		hiMajor, hiMinor = major+1, 0
+	} else {
+		hiMajor, hiMinor = major, minor+1
+	}
+	hi, err := New(hiMajor, hiMinor, 0, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	return []Range{Comparator(OpGTE, lo), Comparator(OpLT, hi)}, nil
+}
+
+func parseCaret(rest string) ([]Range, error) {
+	major, minor, patch, numComps, pre, err := parsePartialVersion(rest)
+	if err != nil {
+		return nil, err
+	}
+	if numComps == 0 {
+		return nil, ErrorInvalidRange
+	}
+
+	lo, err := New(major, minor, patch, pre, "")
+	if err != nil {
+		return nil, err
+	}
+
+	hiMajor, hiMinor, hiPatch := caretUpperBound(major, minor, patch, numComps)
+	hi, err := New(hiMajor, hiMinor, hiPatch, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	return []Range{Comparator(OpGTE, lo), Comparator(OpLT, hi)}, nil
+}
+
+// caretUpperBound computes the exclusive upper bound of a caret range,
+// bumping the left-most non-zero component as required by the npm caret
+// semantics (e.g. ^0.2.3 only allows patch changes, ^0.0.3 allows neither).
+func caretUpperBound(major, minor, patch, numComps int) (int, int, int) {
+	if major != 0 {
+		return major + 1, 0, 0
+	}
+	if numComps == 1 {
+		return 1, 0, 0
+	}
+	if minor != 0 {
+		return 0, minor + 1, 0
+	}
+	if numComps == 2 {
+		return 0, 1, 0
+	}
+	return 0, 0, patch + 1
+}
+
+// partialRangeNodes builds the >= / < comparator pair for an X-range such as
+// "1.2.x" or a bare partial version such as "1.2".
+func partialRangeNodes(major, minor, patch, numComps int) ([]Range, error) {
+	if numComps == 0 {
+		lo, err := New(major, minor, patch, "", "")
+		if err != nil {
+			return nil, err
+		}
+		return []Range{Comparator(OpGTE, lo)}, nil
+	}
+
+	lo, hi, err := partialVersionBounds(major, minor, patch, numComps, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return []Range{Comparator(OpGTE, lo), Comparator(OpLT, hi)}, nil
+}
+
+// parsePartialVersion parses a (possibly incomplete) version such as "1",
+// "1.2", "1.2.3", or "1.2.x", returning the numeric components present,
+// numComps (how many of major/minor/patch were explicitly given), and any
+// pre-release tag. Missing or wildcard ("x", "X", "*") components are
+// reported as 0 and excluded from numComps.
+func parsePartialVersion(s string) (major, minor, patch, numComps int, pre string, err error) {
+	body := s
+	if idx := strings.IndexAny(body, "-+"); idx >= 0 {
+		if body[idx] == '-' {
+			rest := body[idx+1:]
+			if bidx := strings.Index(rest, "+"); bidx >= 0 {
+				rest = rest[:bidx]
+			}
+			pre = rest
+		}
+		body = body[:idx]
+	}
+
+	if body == "" {
+		return 0, 0, 0, 0, "", ErrorInvalidRange
+	}
+
+	parts := strings.Split(body, ".")
+	if len(parts) > 3 {
+		return 0, 0, 0, 0, "", ErrorInvalidRange
+	}
+
+	nums := [3]int{}
+	for i, p := range parts {
+		if p == "x" || p == "X" || p == "*" {
+			break
+		}
+		n, convErr := strconv.Atoi(p)
+		if convErr != nil || n < 0 {
+			return 0, 0, 0, 0, "", ErrorInvalidRange
+		}
+		nums[i] = n
+		numComps = i + 1
+	}
+
+	return nums[0], nums[1], nums[2], numComps, pre, nil
+}
@@ -0,0 +1,136 @@
+package semver
+
+import "testing"
+
+func TestParseRange_Contains(t *testing.T) {
+	tests := []struct {
+		rangeStr string
+		version  string
+		expected bool
+	}{
+		{">=1.2.0 <2.0.0", "1.2.0", true},
+		{">=1.2.0 <2.0.0", "1.9.9", true},
+		{">=1.2.0 <2.0.0", "2.0.0", false},
+		{">=1.2.0 <2.0.0", "1.1.9", false},
+
+		{"~1.2.3", "1.2.3", true},
+		{"~1.2.3", "1.2.9", true},
+		{"~1.2.3", "1.3.0", false},
+		{"~1.2.3", "1.2.2", false},
+		{"~1", "1.9.9", true},
+		{"~1", "2.0.0", false},
+
+		{"^1.2.3", "1.2.3", true},
+		{"^1.2.3", "1.9.9", true},
+		{"^1.2.3", "2.0.0", false},
+		{"^1.2.3", "1.2.2", false},
+		{"^0.2.3", "0.2.9", true},
+		{"^0.2.3", "0.3.0", false},
+		{"^0.0.3", "0.0.3", true},
+		{"^0.0.3", "0.0.4", false},
+
+		{"1.2.x", "1.2.0", true},
+		{"1.2.x", "1.2.9", true},
+		{"1.2.x", "1.3.0", false},
+		{"1.x", "1.5.0", true},
+		{"1.x", "2.0.0", false},
+
+		{">=1.0.0 <2.0.0 || >=3.0.0 <4.0.0", "1.5.0", true},
+		{">=1.0.0 <2.0.0 || >=3.0.0 <4.0.0", "3.5.0", true},
+		{">=1.0.0 <2.0.0 || >=3.0.0 <4.0.0", "2.5.0", false},
+
+		{"1.2.3", "1.2.3", true},
+		{"1.2.3", "1.2.4", false},
+
+		// Operator + partial version: the partial denotes the whole band it
+		// names, so the comparator must be reinterpreted in terms of the
+		// band's bounds rather than treating the missing components as zero.
+		{">1.2", "1.2.5", false},
+		{">1.2", "1.3.0", true},
+		{">=1.2", "1.2.0", true},
+		{">=1.2", "1.1.9", false},
+		{"<=1.2", "1.2.5", true},
+		{"<=1.2", "1.3.0", false},
+		{"<1.2", "1.2.0", false},
+		{"<1.2", "1.1.9", true},
+
+		// Pre-release gating: a pre-release only satisfies a comparator set
+		// that explicitly names the same major.minor.patch with a pre-release.
+		{">=0.0.0", "1.0.0-alpha", false},
+		{">=1.0.0-alpha <2.0.0", "1.0.0-beta", true},
+		{">=1.0.0-alpha <2.0.0", "1.1.0-beta", false},
+	}
+
+	for _, test := range tests {
+		r, err := ParseRange(test.rangeStr)
+		if err != nil {
+			t.Fatalf("ParseRange(%q) returned error: %v", test.rangeStr, err)
+		}
+		v, err := Parse(test.version)
+		if err != nil {
+			t.Fatalf("Parse(%q) returned error: %v", test.version, err)
+		}
+		if got := r.Contains(v); got != test.expected {
+			t.Errorf("ParseRange(%q).Contains(%q) = %v, want %v", test.rangeStr, test.version, got, test.expected)
+		}
+	}
+}
+
+func TestParseRange_Invalid(t *testing.T) {
+	tests := []string{
+		"",
+		"   ",
+		">=",
+		"~",
+		"^",
+		"1.2.3.4",
+	}
+
+	for _, input := range tests {
+		if _, err := ParseRange(input); err == nil {
+			t.Errorf("ParseRange(%q) expected error, got nil", input)
+		}
+	}
+}
+
+func TestMustParseRange(t *testing.T) {
+	r := MustParseRange(">=1.0.0")
+	v, _ := Parse("1.5.0")
+	if !r.Contains(v) {
+		t.Errorf("MustParseRange(\">=1.0.0\").Contains(1.5.0) = false, want true")
+	}
+}
+
+func TestMustParseRange_Panics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MustParseRange did not panic on invalid input")
+		}
+	}()
+	MustParseRange("")
+}
+
+func TestRange_String_RoundTrip(t *testing.T) {
+	tests := []string{
+		">=1.2.0 <2.0.0",
+		"~1.2.3",
+		"^1.2.3",
+	}
+
+	for _, input := range tests {
+		r, err := ParseRange(input)
+		if err != nil {
+			t.Fatalf("ParseRange(%q) returned error: %v", input, err)
+		}
+		r2, err := ParseRange(r.String())
+		if err != nil {
+			t.Fatalf("ParseRange(%q) (round-trip of %q) returned error: %v", r.String(), input, err)
+		}
+		for _, version := range []string{"1.2.3", "1.9.9", "2.0.0", "1.1.9"} {
+			v, _ := Parse(version)
+			if r.Contains(v) != r2.Contains(v) {
+				t.Errorf("round-trip mismatch for %q: Contains(%q) = %v vs %v", input, version, r.Contains(v), r2.Contains(v))
+			}
+		}
+	}
+}
@@ -15,6 +15,7 @@ type Version struct {
 	patch      int    // Patch version
 	preRelease string // Pre-release version (optional)
 	build      string // Build metadata (optional)
+	vPrefix    bool   // Whether the version was parsed with a leading "v"
 }
 
 var (
@@ -101,7 +102,7 @@ func hasLeadingZero(s string) bool {
 }
 
 // String converts a Version struct to its string representation.
-func (v *Version) String() string {
+func (v Version) String() string {
 	version := fmt.Sprintf("%d.%d.%d", v.major, v.minor, v.patch)
 	if v.preRelease != "" {
 		version += "-" + v.preRelease
@@ -113,7 +114,7 @@ func (v *Version) String() string {
 }
 
 // CompareTo compares two versions and returns -1, 0, or 1 if v is less than, equal to, or greater than w.
-func (v *Version) CompareTo(other *Version) int {
+func (v Version) CompareTo(other *Version) int {
 	if v.major < other.major {
 		return -1
 	}
@@ -178,37 +179,37 @@ func compareIdentifiers(this string, other string) int {
 }
 
 // Major returns the major version.
-func (v *Version) Major() int {
+func (v Version) Major() int {
 	return v.major
 }
 
 // Minor returns the minor version.
-func (v *Version) Minor() int {
+func (v Version) Minor() int {
 	return v.minor
 }
 
 // Patch returns the patch version.
-func (v *Version) Patch() int {
+func (v Version) Patch() int {
 	return v.patch
 }
 
 // PreRelease returns the pre-release version.
-func (v *Version) PreRelease() string {
+func (v Version) PreRelease() string {
 	return v.preRelease
 }
 
 // Build returns the build metadata.
-func (v *Version) Build() string {
+func (v Version) Build() string {
 	return v.build
 }
 
 // IsStable returns true if the version is stable (i.e., no pre-release version).
-func (v *Version) IsStable() bool {
+func (v Version) IsStable() bool {
 	return v.preRelease == ""
 }
 
 // IsPreRelease returns true if the version is a pre-release version.
-func (v *Version) IsPreRelease() bool {
+func (v Version) IsPreRelease() bool {
 	return v.preRelease != ""
 }
 
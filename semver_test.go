@@ -12,23 +12,23 @@ func TestParse(t *testing.T) {
 		hasError bool
 	}{
 		// Valid cases
-		{"1.0.0-alpha", &Version{1, 0, 0, "alpha", ""}, false},
-		{"1.0.0-alpha.1", &Version{1, 0, 0, "alpha.1", ""}, false},
-		{"1.0.0-alpha.beta", &Version{1, 0, 0, "alpha.beta", ""}, false},
-		{"1.0.0-beta", &Version{1, 0, 0, "beta", ""}, false},
-		{"1.0.0-beta.2", &Version{1, 0, 0, "beta.2", ""}, false},
-		{"1.0.0-beta.11", &Version{1, 0, 0, "beta.11", ""}, false},
-		{"1.0.0-rc.1", &Version{1, 0, 0, "rc.1", ""}, false},
-		{"1.0.0", &Version{1, 0, 0, "", ""}, false},
-		{"1.0.0-alpha+001", &Version{1, 0, 0, "alpha", "001"}, false},
-		{"1.0.0+20130313144700", &Version{1, 0, 0, "", "20130313144700"}, false},
-		{"1.0.0-beta+exp.sha.5114f85", &Version{1, 0, 0, "beta", "exp.sha.5114f85"}, false},
-		{"1.0.0+21AF26D3----117B344092BD", &Version{1, 0, 0, "", "21AF26D3----117B344092BD"}, false},
-		{"1.0.0-0A", &Version{1, 0, 0, "0A", ""}, false},
+		{"1.0.0-alpha", &Version{1, 0, 0, "alpha", "", false}, false},
+		{"1.0.0-alpha.1", &Version{1, 0, 0, "alpha.1", "", false}, false},
+		{"1.0.0-alpha.beta", &Version{1, 0, 0, "alpha.beta", "", false}, false},
+		{"1.0.0-beta", &Version{1, 0, 0, "beta", "", false}, false},
+		{"1.0.0-beta.2", &Version{1, 0, 0, "beta.2", "", false}, false},
+		{"1.0.0-beta.11", &Version{1, 0, 0, "beta.11", "", false}, false},
+		{"1.0.0-rc.1", &Version{1, 0, 0, "rc.1", "", false}, false},
+		{"1.0.0", &Version{1, 0, 0, "", "", false}, false},
+		{"1.0.0-alpha+001", &Version{1, 0, 0, "alpha", "001", false}, false},
+		{"1.0.0+20130313144700", &Version{1, 0, 0, "", "20130313144700", false}, false},
+		{"1.0.0-beta+exp.sha.5114f85", &Version{1, 0, 0, "beta", "exp.sha.5114f85", false}, false},
+		{"1.0.0+21AF26D3----117B344092BD", &Version{1, 0, 0, "", "21AF26D3----117B344092BD", false}, false},
+		{"1.0.0-0A", &Version{1, 0, 0, "0A", "", false}, false},
 
 		// Edge cases
-		{"0.0.0", &Version{0, 0, 0, "", ""}, false},                                                 // Minimum version
-		{"999999999.999999999.999999999", &Version{999999999, 999999999, 999999999, "", ""}, false}, // Large numbers
+		{"0.0.0", &Version{0, 0, 0, "", "", false}, false},                                                 // Minimum version
+		{"999999999.999999999.999999999", &Version{999999999, 999999999, 999999999, "", "", false}, false}, // Large numbers
 		{"1.0.0-01", nil, true},       // Leading zero in pre-release
 		{"1.0.0-.", nil, true},        // Invalid pre-release format
 		{"1.0.0-..", nil, true},       // Invalid pre-release format
@@ -37,8 +37,8 @@ func TestParse(t *testing.T) {
 		{"1.0.0+!@#$%^&*", nil, true}, // Invalid build metadata
 		{"1.0.0-", nil, true},         // Trailing hyphen
 		{"1.0.0+", nil, true},         // Trailing plus
-		{"1.0.0-rc.1+build.1", &Version{1, 0, 0, "rc.1", "build.1"}, false},         // Valid pre-release and build
-		{"1.0.0-rc.1+build.1.2.3", &Version{1, 0, 0, "rc.1", "build.1.2.3"}, false}, // Valid pre-release and build with multiple identifiers
+		{"1.0.0-rc.1+build.1", &Version{1, 0, 0, "rc.1", "build.1", false}, false},         // Valid pre-release and build
+		{"1.0.0-rc.1+build.1.2.3", &Version{1, 0, 0, "rc.1", "build.1.2.3", false}, false}, // Valid pre-release and build with multiple identifiers
 
 		// Invalid cases
 		{"invalid.version", nil, true},
@@ -66,23 +66,23 @@ func TestVersionString(t *testing.T) {
 		expected string
 	}{
 		// Basic version
-		{Version{1, 0, 0, "", ""}, "1.0.0"},
-		{Version{2, 1, 3, "", ""}, "2.1.3"},
+		{Version{1, 0, 0, "", "", false}, "1.0.0"},
+		{Version{2, 1, 3, "", "", false}, "2.1.3"},
 
 		// With pre-release
-		{Version{1, 0, 0, "alpha", ""}, "1.0.0-alpha"},
-		{Version{1, 0, 0, "alpha.1", ""}, "1.0.0-alpha.1"},
-		{Version{1, 0, 0, "beta", ""}, "1.0.0-beta"},
-		{Version{1, 0, 0, "rc.1", ""}, "1.0.0-rc.1"},
+		{Version{1, 0, 0, "alpha", "", false}, "1.0.0-alpha"},
+		{Version{1, 0, 0, "alpha.1", "", false}, "1.0.0-alpha.1"},
+		{Version{1, 0, 0, "beta", "", false}, "1.0.0-beta"},
+		{Version{1, 0, 0, "rc.1", "", false}, "1.0.0-rc.1"},
 
 		// With build metadata
-		{Version{1, 0, 0, "", "001"}, "1.0.0+001"},
-		{Version{1, 0, 0, "", "exp.sha.5114f85"}, "1.0.0+exp.sha.5114f85"},
+		{Version{1, 0, 0, "", "001", false}, "1.0.0+001"},
+		{Version{1, 0, 0, "", "exp.sha.5114f85", false}, "1.0.0+exp.sha.5114f85"},
 
 		// With pre-release and build metadata
-		{Version{1, 0, 0, "alpha", "001"}, "1.0.0-alpha+001"},
-		{Version{1, 0, 0, "beta", "exp.sha.5114f85"}, "1.0.0-beta+exp.sha.5114f85"},
-		{Version{1, 0, 0, "rc.1", "build.1.2.3"}, "1.0.0-rc.1+build.1.2.3"},
+		{Version{1, 0, 0, "alpha", "001", false}, "1.0.0-alpha+001"},
+		{Version{1, 0, 0, "beta", "exp.sha.5114f85", false}, "1.0.0-beta+exp.sha.5114f85"},
+		{Version{1, 0, 0, "rc.1", "build.1.2.3", false}, "1.0.0-rc.1+build.1.2.3"},
 	}
 
 	for _, test := range tests {
@@ -100,32 +100,32 @@ func TestVersion_CompareTo(t *testing.T) {
 		expected int
 	}{
 		// Major version
-		{Version{1, 0, 0, "", ""}, Version{2, 0, 0, "", ""}, -1},
-		{Version{2, 0, 0, "", ""}, Version{1, 0, 0, "", ""}, 1},
-		{Version{1, 0, 0, "", ""}, Version{1, 0, 0, "", ""}, 0},
+		{Version{1, 0, 0, "", "", false}, Version{2, 0, 0, "", "", false}, -1},
+		{Version{2, 0, 0, "", "", false}, Version{1, 0, 0, "", "", false}, 1},
+		{Version{1, 0, 0, "", "", false}, Version{1, 0, 0, "", "", false}, 0},
 
 		// Minor version
-		{Version{1, 0, 0, "", ""}, Version{1, 1, 0, "", ""}, -1},
-		{Version{1, 1, 0, "", ""}, Version{1, 0, 0, "", ""}, 1},
-		{Version{1, 0, 0, "", ""}, Version{1, 0, 0, "", ""}, 0},
+		{Version{1, 0, 0, "", "", false}, Version{1, 1, 0, "", "", false}, -1},
+		{Version{1, 1, 0, "", "", false}, Version{1, 0, 0, "", "", false}, 1},
+		{Version{1, 0, 0, "", "", false}, Version{1, 0, 0, "", "", false}, 0},
 
 		// Patch version
-		{Version{1, 0, 0, "", ""}, Version{1, 0, 1, "", ""}, -1},
-		{Version{1, 0, 1, "", ""}, Version{1, 0, 0, "", ""}, 1},
-		{Version{1, 0, 0, "", ""}, Version{1, 0, 0, "", ""}, 0},
+		{Version{1, 0, 0, "", "", false}, Version{1, 0, 1, "", "", false}, -1},
+		{Version{1, 0, 1, "", "", false}, Version{1, 0, 0, "", "", false}, 1},
+		{Version{1, 0, 0, "", "", false}, Version{1, 0, 0, "", "", false}, 0},
 
 		// Pre-release
-		{Version{1, 0, 0, "alpha", ""}, Version{1, 0, 0, "beta", ""}, -1},
-		{Version{1, 0, 0, "beta", ""}, Version{1, 0, 0, "alpha", ""}, 1},
-		{Version{1, 0, 0, "alpha", ""}, Version{1, 0, 0, "alpha", ""}, 0},
-		{Version{1, 0, 0, "alpha", ""}, Version{1, 0, 0, "alpha.1", ""}, -1},
-		{Version{1, 0, 0, "alpha.1", ""}, Version{1, 0, 0, "alpha", ""}, 1},
-		{Version{1, 0, 0, "alpha.1", ""}, Version{1, 0, 0, "alpha.1", ""}, 0},
-		{Version{1, 0, 0, "alpha.1", ""}, Version{1, 0, 0, "alpha.2", ""}, -1},
-		{Version{1, 0, 0, "alpha.2", ""}, Version{1, 0, 0, "alpha.1", ""}, 1},
+		{Version{1, 0, 0, "alpha", "", false}, Version{1, 0, 0, "beta", "", false}, -1},
+		{Version{1, 0, 0, "beta", "", false}, Version{1, 0, 0, "alpha", "", false}, 1},
+		{Version{1, 0, 0, "alpha", "", false}, Version{1, 0, 0, "alpha", "", false}, 0},
+		{Version{1, 0, 0, "alpha", "", false}, Version{1, 0, 0, "alpha.1", "", false}, -1},
+		{Version{1, 0, 0, "alpha.1", "", false}, Version{1, 0, 0, "alpha", "", false}, 1},
+		{Version{1, 0, 0, "alpha.1", "", false}, Version{1, 0, 0, "alpha.1", "", false}, 0},
+		{Version{1, 0, 0, "alpha.1", "", false}, Version{1, 0, 0, "alpha.2", "", false}, -1},
+		{Version{1, 0, 0, "alpha.2", "", false}, Version{1, 0, 0, "alpha.1", "", false}, 1},
 
 		// Build metadata (ignored)
-		{Version{1, 0, 0, "", "001"}, Version{1, 0, 0, "", "002"}, 0},
+		{Version{1, 0, 0, "", "001", false}, Version{1, 0, 0, "", "002", false}, 0},
 	}
 
 	for _, test := range tests {
@@ -141,8 +141,8 @@ func TestVersion_IncreaseMajor(t *testing.T) {
 		version  Version
 		expected Version
 	}{
-		{Version{1, 0, 0, "", ""}, Version{2, 0, 0, "", ""}},
-		{Version{2, 1, 3, "", ""}, Version{3, 0, 0, "", ""}},
+		{Version{1, 0, 0, "", "", false}, Version{2, 0, 0, "", "", false}},
+		{Version{2, 1, 3, "", "", false}, Version{3, 0, 0, "", "", false}},
 	}
 
 	for _, test := range tests {
@@ -158,8 +158,8 @@ func TestVersion_IncreaseMinor(t *testing.T) {
 		version  Version
 		expected Version
 	}{
-		{Version{1, 0, 0, "", ""}, Version{1, 1, 0, "", ""}},
-		{Version{2, 1, 3, "", ""}, Version{2, 2, 0, "", ""}},
+		{Version{1, 0, 0, "", "", false}, Version{1, 1, 0, "", "", false}},
+		{Version{2, 1, 3, "", "", false}, Version{2, 2, 0, "", "", false}},
 	}
 
 	for _, test := range tests {
@@ -175,8 +175,8 @@ func TestVersion_IncreasePatch(t *testing.T) {
 		version  Version
 		expected Version
 	}{
-		{Version{1, 0, 0, "", ""}, Version{1, 0, 1, "", ""}},
-		{Version{2, 1, 3, "", ""}, Version{2, 1, 4, "", ""}},
+		{Version{1, 0, 0, "", "", false}, Version{1, 0, 1, "", "", false}},
+		{Version{2, 1, 3, "", "", false}, Version{2, 1, 4, "", "", false}},
 	}
 
 	for _, test := range tests {
@@ -192,8 +192,8 @@ func TestVersion_IncreasePreRelease(t *testing.T) {
 		version  Version
 		expected Version
 	}{
-		{Version{1, 0, 0, "alpha", ""}, Version{1, 0, 0, "alpha.1", ""}},
-		{Version{1, 0, 0, "alpha.1", ""}, Version{1, 0, 0, "alpha.2", ""}},
+		{Version{1, 0, 0, "alpha", "", false}, Version{1, 0, 0, "alpha.1", "", false}},
+		{Version{1, 0, 0, "alpha.1", "", false}, Version{1, 0, 0, "alpha.2", "", false}},
 	}
 
 	for _, test := range tests {
@@ -209,8 +209,8 @@ func TestVersion_IsStable(t *testing.T) {
 		version  Version
 		expected bool
 	}{
-		{Version{1, 0, 0, "", ""}, true},
-		{Version{1, 0, 0, "alpha", ""}, false},
+		{Version{1, 0, 0, "", "", false}, true},
+		{Version{1, 0, 0, "alpha", "", false}, false},
 	}
 
 	for _, test := range tests {
@@ -226,8 +226,8 @@ func TestVersion_IsPreRelease(t *testing.T) {
 		version  Version
 		expected bool
 	}{
-		{Version{1, 0, 0, "", ""}, false},
-		{Version{1, 0, 0, "alpha", ""}, true},
+		{Version{1, 0, 0, "", "", false}, false},
+		{Version{1, 0, 0, "alpha", "", false}, true},
 	}
 
 	for _, test := range tests {
@@ -243,8 +243,8 @@ func TestVersion_Major(t *testing.T) {
 		version  Version
 		expected int
 	}{
-		{Version{1, 0, 0, "", ""}, 1},
-		{Version{2, 1, 3, "", ""}, 2},
+		{Version{1, 0, 0, "", "", false}, 1},
+		{Version{2, 1, 3, "", "", false}, 2},
 	}
 
 	for _, test := range tests {
@@ -260,8 +260,8 @@ func TestVersion_Minor(t *testing.T) {
 		version  Version
 		expected int
 	}{
-		{Version{1, 0, 0, "", ""}, 0},
-		{Version{2, 1, 3, "", ""}, 1},
+		{Version{1, 0, 0, "", "", false}, 0},
+		{Version{2, 1, 3, "", "", false}, 1},
 	}
 
 	for _, test := range tests {
@@ -277,8 +277,8 @@ func TestVersion_Patch(t *testing.T) {
 		version  Version
 		expected int
 	}{
-		{Version{1, 0, 0, "", ""}, 0},
-		{Version{2, 1, 3, "", ""}, 3},
+		{Version{1, 0, 0, "", "", false}, 0},
+		{Version{2, 1, 3, "", "", false}, 3},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,61 @@
+package semver
+
+import "sort"
+
+// Versions is a slice of versions that implements sort.Interface, ordering
+// elements from lowest to highest according to CompareTo.
+type Versions []*Version
+
+// Len implements sort.Interface.
+func (vs Versions) Len() int {
+	return len(vs)
+}
+
+// Less implements sort.Interface.
+func (vs Versions) Less(i, j int) bool {
+	return vs[i].CompareTo(vs[j]) < 0
+}
+
+// Swap implements sort.Interface.
+func (vs Versions) Swap(i, j int) {
+	vs[i], vs[j] = vs[j], vs[i]
+}
+
+// Sort sorts vs in place from lowest to highest version.
+func Sort(vs []*Version) {
+	sort.Sort(Versions(vs))
+}
+
+// SortStable sorts vs in place from lowest to highest version, preserving
+// the relative order of equal versions.
+func SortStable(vs []*Version) {
+	sort.Stable(Versions(vs))
+}
+
+// Min returns the lowest version in vs, or nil if vs is empty.
+func Min(vs []*Version) *Version {
+	if len(vs) == 0 {
+		return nil
+	}
+	min := vs[0]
+	for _, v := range vs[1:] {
+		if v.CompareTo(min) < 0 {
+			min = v
+		}
+	}
+	return min
+}
+
+// Max returns the highest version in vs, or nil if vs is empty.
+func Max(vs []*Version) *Version {
+	if len(vs) == 0 {
+		return nil
+	}
+	max := vs[0]
+	for _, v := range vs[1:] {
+		if v.CompareTo(max) > 0 {
+			max = v
+		}
+	}
+	return max
+}
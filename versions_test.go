@@ -0,0 +1,72 @@
+package semver
+
+import (
+	"sort"
+	"testing"
+)
+
+func mustParseAll(t *testing.T, ss ...string) []*Version {
+	t.Helper()
+	vs := make([]*Version, len(ss))
+	for i, s := range ss {
+		v, err := Parse(s)
+		if err != nil {
+			t.Fatalf("Parse(%q) returned error: %v", s, err)
+		}
+		vs[i] = v
+	}
+	return vs
+}
+
+func TestVersions_Sort(t *testing.T) {
+	vs := mustParseAll(t, "1.2.3", "1.0.0", "2.0.0", "1.2.3-alpha")
+	Sort(vs)
+
+	var got []string
+	for _, v := range vs {
+		got = append(got, v.String())
+	}
+
+	want := []string{"1.0.0", "1.2.3-alpha", "1.2.3", "2.0.0"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Sort() = %v, want %v", got, want)
+			break
+		}
+	}
+
+	if !sort.IsSorted(Versions(vs)) {
+		t.Errorf("Versions(%v) is not sorted", got)
+	}
+}
+
+func TestVersions_SortStable(t *testing.T) {
+	vs := mustParseAll(t, "1.0.0+build.2", "1.0.0+build.1")
+	SortStable(vs)
+
+	if vs[0].Build() != "build.2" || vs[1].Build() != "build.1" {
+		t.Errorf("SortStable() did not preserve relative order of equal versions, got %v", vs)
+	}
+}
+
+func TestMin(t *testing.T) {
+	vs := mustParseAll(t, "1.2.3", "1.0.0", "2.0.0")
+	if got := Min(vs); got.String() != "1.0.0" {
+		t.Errorf("Min() = %v, want 1.0.0", got)
+	}
+
+	if got := Min(nil); got != nil {
+		t.Errorf("Min(nil) = %v, want nil", got)
+	}
+}
+
+func TestMax(t *testing.T) {
+	vs := mustParseAll(t, "1.2.3", "1.0.0", "2.0.0")
+	if got := Max(vs); got.String() != "2.0.0" {
+		t.Errorf("Max() = %v, want 2.0.0", got)
+	}
+
+	if got := Max(nil); got != nil {
+		t.Errorf("Max(nil) = %v, want nil", got)
+	}
+}